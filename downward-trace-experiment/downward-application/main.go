@@ -3,14 +3,12 @@ package main
 
 import (
 	"context"
-	"encoding/base64"
 	"log"
 	"os"
 	"time"
 
-	"contrib.go.opencensus.io/exporter/stackdriver"
 	"go.opencensus.io/trace"
-	"go.opencensus.io/trace/propagation"
+	"k8s.io/kubernetes/pkg/util/trace"
 )
 
 func main() {
@@ -20,32 +18,31 @@ func main() {
 	log.Println("Downward API passed trace context: ", traceContext)
 	log.Println("Another test")
 
-	// Create an register a OpenCensus
-	// Stackdriver Trace exporter.
-	exporter, err := stackdriver.NewExporter(stackdriver.Options{
-		ProjectID: "samnaser-gke-dev-217421",
-	})
-	if err != nil {
+	// Register the configured trace exporter through the shared traceutil
+	// factory instead of constructing a Stackdriver client directly.
+	cfg := traceutil.TracingConfig{
+		ExporterName: "stackdriver",
+		Config: traceutil.ExporterConfig{
+			ProjectID: os.Getenv("TRACE_PROJECT_ID"),
+		},
+		// ParentOrProbabilitySampler honors the sampled bit of a remote parent. The span below is
+		// started as a root span that is only linked to the incoming trace context, so it is
+		// sampled at the 0.1 fallback rate.
+		Sampler: traceutil.ParentOrProbabilitySampler(0.1),
+	}
+	if err := traceutil.InitializeExporter(traceutil.ServiceType("downward-application"), cfg); err != nil {
 		log.Fatal(err)
 	}
 
-	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
-	trace.RegisterExporter(exporter)
-
 	log.Println("Stackdriver exporter created.")
 
-	decodedContextBytes, err := base64.StdEncoding.DecodeString(traceContext)
+	spanContext, err := traceutil.SpanContextFromW3C(traceContext)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	log.Println("Decoded context.")
 
-	spanContext, ok := propagation.FromBinary(decodedContextBytes)
-	if !ok {
-		log.Fatalf("could not convert raw bytes to trace")
-	}
-
 	log.Println("Trace ID: ", spanContext.TraceID)
 
 	_, span := trace.StartSpan(context.Background(), "ApplicationLevelTrace")
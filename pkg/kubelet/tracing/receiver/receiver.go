@@ -0,0 +1,164 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package receiver implements a node-local Zipkin v2 JSON span receiver embedded in the kubelet.
+// Co-located pods export to a stable localhost endpoint instead of a remote collector address;
+// the receiver decorates each received span with the pod/node identity it can attribute from the
+// source IP, then forwards it through the cluster-configured traceutil exporter.
+//
+// Only the JSON encoding of the Zipkin v2 API is supported; no protobuf codec is vendored, so
+// "application/x-protobuf" requests are rejected with http.StatusUnsupportedMediaType the same as
+// any other unrecognized content type.
+package receiver
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/golang/glog"
+	zipkinmodel "github.com/openzipkin/zipkin-go/model"
+	"go.opencensus.io/trace"
+
+	"k8s.io/kubernetes/pkg/kubelet/pod"
+	traceutil "k8s.io/kubernetes/pkg/util/trace"
+)
+
+// spansPath is the Zipkin v2 HTTP span ingestion path, matching the public collector API so that
+// off-the-shelf OpenCensus/Zipkin exporters can point at the kubelet without modification.
+const spansPath = "/api/v2/spans"
+
+// Receiver is a node-local Zipkin v2 HTTP receiver. It accepts spans from pods on the same node,
+// attributes each span to the pod it came from, and forwards it to the configured trace exporter.
+type Receiver struct {
+	// Addr is the local address (e.g. "127.0.0.1:9999") the receiver listens on.
+	Addr string
+	// PodManager resolves a source IP to the Pod it belongs to.
+	PodManager pod.Manager
+	// NodeName is attached to every forwarded span.
+	NodeName string
+	// Export is called for each decorated span; defaults to traceutil's configured exporter.
+	Export func(*trace.SpanData)
+
+	server *http.Server
+}
+
+// NewReceiver constructs a Receiver listening on addr, attributing spans via podManager.
+func NewReceiver(addr string, podManager pod.Manager, nodeName string) *Receiver {
+	return &Receiver{
+		Addr:       addr,
+		PodManager: podManager,
+		NodeName:   nodeName,
+		Export:     exportToConfiguredExporter,
+	}
+}
+
+// ListenAndServe starts the receiver's HTTP server and blocks until it returns an error.
+func (r *Receiver) ListenAndServe() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(spansPath, r.handleSpans)
+	r.server = &http.Server{Addr: r.Addr, Handler: mux}
+
+	glog.Infof("kubelet trace receiver listening on %s%s", r.Addr, spansPath)
+	return r.server.ListenAndServe()
+}
+
+// Shutdown gracefully stops the receiver's HTTP server.
+func (r *Receiver) Shutdown() error {
+	if r.server == nil {
+		return nil
+	}
+	return r.server.Close()
+}
+
+// handleSpans accepts a batch of Zipkin v2 spans encoded as JSON, decorates each with the
+// source pod's identity, and forwards it to the exporter. Any other content type is rejected.
+func (r *Receiver) handleSpans(w http.ResponseWriter, req *http.Request) {
+	if ct := req.Header.Get("Content-Type"); ct != "" && ct != "application/json" {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", ct), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var spans []zipkinmodel.SpanModel
+	if err := json.NewDecoder(req.Body).Decode(&spans); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode spans: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	sourceIP, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		sourceIP = req.RemoteAddr
+	}
+
+	extra := r.podAttributes(sourceIP)
+	for _, s := range spans {
+		r.Export(decorateSpan(s, extra))
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// podAttributes looks up the Pod at sourceIP via the kubelet's pod manager and returns the
+// attributes to annotate its spans with. The app itself cannot forge these, since they are
+// derived from the kubelet's own view of which pod owns that IP.
+func (r *Receiver) podAttributes(sourceIP string) map[string]string {
+	attrs := map[string]string{traceutil.AttrNode: r.NodeName}
+
+	p, ok := r.PodManager.GetPodByIP(sourceIP)
+	if !ok {
+		glog.V(4).Infof("trace receiver: no pod found for source IP %s", sourceIP)
+		return attrs
+	}
+
+	attrs[traceutil.AttrNamespace] = p.Namespace
+	attrs[traceutil.AttrName] = p.Name
+	attrs[traceutil.AttrUID] = string(p.UID)
+	attrs[traceutil.AttrPodIP] = sourceIP
+	return attrs
+}
+
+// decorateSpan converts a Zipkin v2 span into an OpenCensus trace.SpanData tagged with extra.
+func decorateSpan(s zipkinmodel.SpanModel, extra map[string]string) *trace.SpanData {
+	var traceID trace.TraceID
+	binary.BigEndian.PutUint64(traceID[0:8], s.TraceID.High)
+	binary.BigEndian.PutUint64(traceID[8:16], s.TraceID.Low)
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(spanID[:], uint64(s.ID))
+
+	attrs := map[string]interface{}{}
+	for k, v := range s.Tags {
+		attrs[k] = v
+	}
+	for k, v := range extra {
+		attrs[k] = v
+	}
+
+	return &trace.SpanData{
+		SpanContext: trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		Name:        s.Name,
+		StartTime:   s.Timestamp,
+		EndTime:     s.Timestamp.Add(s.Duration),
+		Attributes:  attrs,
+	}
+}
+
+// exportToConfiguredExporter is the default Export implementation, forwarding to whatever
+// exporter traceutil.InitializeExporter most recently registered.
+func exportToConfiguredExporter(sd *trace.SpanData) {
+	traceutil.ExportSpanData(sd)
+}
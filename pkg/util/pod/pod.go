@@ -63,7 +63,8 @@ func preparePatchBytesforPodStatus(namespace, name string, oldPodStatus, newPodS
 	return patchBytes, nil
 }
 
-// ReplacePodTraceContext patches trace context for a given pod
+// ReplacePodTraceContext patches trace context for a given pod. newTraceContext should be encoded per
+// traceutil.CurrentPropagationFormat (a W3C traceparent string by default).
 func ReplacePodTraceContext(c clientset.Interface, namespace, name, newTraceContext string, oldObjectMeta metav1.ObjectMeta) (*v1.Pod, error) {
 
 	newObjectMeta := oldObjectMeta
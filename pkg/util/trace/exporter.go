@@ -0,0 +1,91 @@
+package traceutil
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opencensus.io/trace"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExporterConfig carries the endpoint, project, and credential knobs needed to
+// construct a trace.Exporter. Not every field is meaningful to every exporter;
+// each ExporterFactory reads only the fields it understands.
+type ExporterConfig struct {
+	// Address is the collector endpoint the exporter should talk to (e.g. a
+	// Zipkin HTTP collector URL or a Jaeger/OTLP gRPC target).
+	Address string
+	// ServiceAddress is the local service's own address, advertised to the
+	// collector as the endpoint spans originate from.
+	ServiceAddress string
+	// ProjectID is used by cloud-backed exporters such as Stackdriver.
+	ProjectID string
+	// CredentialsFile optionally points at credentials for the backend.
+	CredentialsFile string
+}
+
+// ExporterFactory constructs a trace.Exporter from an ExporterConfig.
+type ExporterFactory func(cfg ExporterConfig) (trace.Exporter, error)
+
+// TracingConfig is the typed configuration loaded from a ComponentConfig file
+// or the --tracing-config flag on api-server/scheduler/kubelet. It selects
+// which registered exporter factory to use and supplies its configuration.
+type TracingConfig struct {
+	// ExporterName selects the registered factory (e.g. "zipkin", "jaeger").
+	ExporterName string
+	Config       ExporterConfig
+	// Sampler is the default sampler applied globally when set. Leave nil to
+	// keep whatever sampler trace.ApplyConfig previously configured.
+	Sampler trace.Sampler
+	// VerbSamplers overrides Sampler for specific API verbs, e.g. sampling
+	// "CREATE pods" at 100% and "LIST" at 0.1%. Keys are free-form strings
+	// the caller chooses to match against (e.g. "CREATE pods", "LIST").
+	VerbSamplers map[string]trace.Sampler
+	// ObjectSampler, when set, is consulted by SpanFromEncodedContext for every span, receiving
+	// the traced object and the sampler SamplerForVerb selected as fallback. This is how an
+	// object-aware policy (e.g. DebugHeaderSampler, to force-sample an annotated Pod) composes
+	// with the verb-based policy above instead of replacing it outright.
+	ObjectSampler func(obj meta.Object, fallback trace.Sampler) trace.Sampler
+}
+
+// SamplerForVerb returns the sampler configured for verb, falling back to cfg.Sampler when no
+// per-verb override is set.
+func (cfg TracingConfig) SamplerForVerb(verb string) trace.Sampler {
+	if s, ok := cfg.VerbSamplers[verb]; ok {
+		return s
+	}
+	return cfg.Sampler
+}
+
+var (
+	exporterFactoriesMu sync.RWMutex
+	exporterFactories   = map[string]ExporterFactory{}
+)
+
+// RegisterExporterFactory registers f under name so that a TracingConfig can
+// select it by name. Re-registering an existing name overwrites it, which lets
+// tests and alternate builds substitute their own factories.
+func RegisterExporterFactory(name string, f ExporterFactory) {
+	exporterFactoriesMu.Lock()
+	defer exporterFactoriesMu.Unlock()
+	exporterFactories[name] = f
+}
+
+// newExporter looks up the factory registered under cfg.ExporterName and uses
+// it to build a trace.Exporter from cfg.Config.
+func newExporter(cfg TracingConfig) (trace.Exporter, error) {
+	exporterFactoriesMu.RLock()
+	f, ok := exporterFactories[cfg.ExporterName]
+	exporterFactoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no trace exporter factory registered for %q", cfg.ExporterName)
+	}
+	return f(cfg.Config)
+}
+
+func init() {
+	RegisterExporterFactory("zipkin", newZipkinExporter)
+	RegisterExporterFactory("stackdriver", newStackdriverExporter)
+	RegisterExporterFactory("jaeger", newJaegerExporter)
+	RegisterExporterFactory("otlp", newOTLPExporter)
+}
@@ -0,0 +1,63 @@
+package traceutil
+
+import (
+	"fmt"
+
+	"contrib.go.opencensus.io/exporter/stackdriver"
+	"go.opencensus.io/exporter/jaeger"
+	"go.opencensus.io/exporter/zipkin"
+	"go.opencensus.io/trace"
+
+	"contrib.go.opencensus.io/exporter/ocagent"
+	openzipkin "github.com/openzipkin/zipkin-go"
+	zipkinHTTP "github.com/openzipkin/zipkin-go/reporter/http"
+)
+
+// newZipkinExporter builds the Zipkin exporter InitializeExporter used to
+// construct inline before this factory existed. cfg.ServiceAddress is the
+// local endpoint advertised to the collector at cfg.Address.
+func newZipkinExporter(cfg ExporterConfig) (trace.Exporter, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("zipkin exporter requires a collector Address")
+	}
+	localEndpoint, err := openzipkin.NewEndpoint("", cfg.ServiceAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the local zipkinEndpoint: %v", err)
+	}
+	reporter := zipkinHTTP.NewReporter(cfg.Address)
+	return zipkin.NewExporter(reporter, localEndpoint), nil
+}
+
+// newStackdriverExporter builds a Stackdriver Trace exporter for cfg.ProjectID.
+func newStackdriverExporter(cfg ExporterConfig) (trace.Exporter, error) {
+	if cfg.ProjectID == "" {
+		return nil, fmt.Errorf("stackdriver exporter requires a ProjectID")
+	}
+	return stackdriver.NewExporter(stackdriver.Options{ProjectID: cfg.ProjectID})
+}
+
+// newJaegerExporter builds a Jaeger exporter reporting to cfg.Address's
+// collector endpoint.
+func newJaegerExporter(cfg ExporterConfig) (trace.Exporter, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("jaeger exporter requires a collector Address")
+	}
+	return jaeger.NewExporter(jaeger.Options{
+		CollectorEndpoint: cfg.Address,
+		Process:           jaeger.Process{ServiceName: cfg.ServiceAddress},
+	})
+}
+
+// newOTLPExporter, registered as "otlp", builds an OpenCensus Agent (ocagent)
+// exporter dialing the collector at cfg.Address. It speaks the OpenCensus
+// agent protocol, not the OpenTelemetry Protocol itself.
+func newOTLPExporter(cfg ExporterConfig) (trace.Exporter, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("otlp exporter requires a collector Address")
+	}
+	return ocagent.NewExporter(
+		ocagent.WithAddress(cfg.Address),
+		ocagent.WithServiceName(cfg.ServiceAddress),
+		ocagent.WithInsecure(),
+	)
+}
@@ -0,0 +1,75 @@
+package traceutil
+
+import (
+	"sync"
+	"time"
+
+	"go.opencensus.io/trace"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ForceSampleAnnotation, when set to "true" on an object, forces DebugHeaderSampler to sample
+// every span derived from that object regardless of the configured probability. This lets
+// operators trace a single Pod end-to-end without flooding the collector.
+const ForceSampleAnnotation = "trace.kubernetes.io/force-sample"
+
+// RateLimitedSampler returns a trace.Sampler that admits at most qps spans per second using a
+// simple token bucket, refilled once per second.
+func RateLimitedSampler(qps int) trace.Sampler {
+	b := &tokenBucket{capacity: qps, tokens: qps, refillEvery: time.Second}
+	return func(p trace.SamplingParameters) trace.SamplingDecision {
+		return trace.SamplingDecision{Sample: b.take()}
+	}
+}
+
+// ParentOrProbabilitySampler returns a trace.Sampler that honors the sampled bit carried by an
+// incoming remote parent SpanContext, and falls back to a ProbabilitySampler(fraction) decision
+// for root spans (those with no parent).
+func ParentOrProbabilitySampler(fraction float64) trace.Sampler {
+	fallback := trace.ProbabilitySampler(fraction)
+	return func(p trace.SamplingParameters) trace.SamplingDecision {
+		if p.HasRemoteParent {
+			return trace.SamplingDecision{Sample: p.ParentContext.IsSampled()}
+		}
+		return fallback(p)
+	}
+}
+
+// DebugHeaderSampler force-samples obj if it carries ForceSampleAnnotation="true", and otherwise
+// returns fallback unchanged. Its signature matches TracingConfig.ObjectSampler, so it can be
+// assigned directly: SpanFromEncodedContext calls it with the verb-selected sampler as fallback,
+// letting operators trace a single annotated Pod end-to-end on top of whatever verb policy is
+// already configured.
+func DebugHeaderSampler(obj meta.Object, fallback trace.Sampler) trace.Sampler {
+	if obj != nil && obj.GetAnnotations()[ForceSampleAnnotation] == "true" {
+		return trace.AlwaysSample()
+	}
+	return fallback
+}
+
+// tokenBucket is a minimal token-bucket rate limiter: refillEvery seconds, it tops back up to
+// capacity in one shot rather than trickling tokens in continuously, which is sufficient for a
+// coarse per-verb sampling cap.
+type tokenBucket struct {
+	mu          sync.Mutex
+	capacity    int
+	tokens      int
+	refillEvery time.Duration
+	lastRefill  time.Time
+}
+
+func (b *tokenBucket) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(b.lastRefill) >= b.refillEvery {
+		b.tokens = b.capacity
+		b.lastRefill = now
+	}
+	if b.tokens <= 0 {
+		return false
+	}
+	b.tokens--
+	return true
+}
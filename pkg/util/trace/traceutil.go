@@ -8,24 +8,13 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/golang/glog"
-	"go.opencensus.io/exporter/zipkin"
 	"go.opencensus.io/trace"
 	"go.opencensus.io/trace/propagation"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
-
-	openzipkin "github.com/openzipkin/zipkin-go"
-	zipkinHTTP "github.com/openzipkin/zipkin-go/reporter/http"
-)
-
-// trace exporter configuration
-const (
-	DefaultTraceAddress     = "192.168.1.5"
-	DefaultTracePort        = "5454"
-	DefaultCollectorAddress = "http://35.193.38.26:9411/api/v2/spans"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // services a given span could export from
@@ -40,28 +29,42 @@ const (
 // ServiceType represents a logical service within Kubernetes
 type ServiceType string
 
-// InitializeExporter takes a ServiceType and sets the global OpenCensus exporter
-// to export to that service on a specified Zipkin instance
-func InitializeExporter(service ServiceType) error {
+// currentExporter is the exporter most recently built by InitializeExporter,
+// reused by EndRootObjectTraceWithName instead of constructing a fresh one
+// on every call.
+var currentExporter trace.Exporter
+
+// currentConfig is the TracingConfig most recently applied by InitializeExporter, reused by
+// SpanFromEncodedContext to resolve the sampler for each span.
+var currentConfig TracingConfig
+
+// InitializeExporter takes a ServiceType and a TracingConfig and sets the
+// global OpenCensus exporter to the backend selected by cfg.ExporterName
+func InitializeExporter(service ServiceType, cfg TracingConfig) error {
 
-	glog.Infof("OpenCensus trace exporter initializing with service %s", string(service))
+	glog.Infof("OpenCensus trace exporter initializing with service %s via %q", string(service), cfg.ExporterName)
 
-	// create zipkin exporter
-	localEndpoint, err := openzipkin.NewEndpoint(string(service), fmt.Sprintf("%s:%s", DefaultTraceAddress, DefaultTracePort))
+	exporter, err := newExporter(cfg)
 	if err != nil {
-		glog.Errorf("failed to create the local zipkinEndpoint: %v", err)
+		return fmt.Errorf("failed to build %q trace exporter: %v", cfg.ExporterName, err)
 	}
-	reporter := zipkinHTTP.NewReporter(DefaultCollectorAddress)
-	ze := zipkin.NewExporter(reporter, localEndpoint)
 
-	trace.RegisterExporter(ze)
+	currentExporter = exporter
+	currentConfig = cfg
+	trace.RegisterExporter(exporter)
+
+	if cfg.Sampler != nil {
+		trace.ApplyConfig(trace.Config{DefaultSampler: cfg.Sampler})
+	}
 
 	return nil
 }
 
-// SpanFromEncodedContext takes an object to extract trace context from and the desired Span name and
-// constructs a new Span from this information
-func SpanFromEncodedContext(tracedResource meta.Object, name string) (ctx context.Context, result *trace.Span, err error) {
+// SpanFromEncodedContext takes an object to extract trace context from, its GroupVersionKind, the API verb
+// being served, and the desired Span name, and constructs a new Span from this information. The sampler
+// backing the span is currentConfig.SamplerForVerb(verb), refined through currentConfig.ObjectSampler (if
+// set) so that e.g. a force-sample annotation on tracedResource can override the verb's default policy.
+func SpanFromEncodedContext(tracedResource meta.Object, gvk schema.GroupVersionKind, verb, name string) (ctx context.Context, result *trace.Span, err error) {
 
 	glog.Infof("creating span from SpanContext encoded in object %s", tracedResource.GetName())
 	spanFromEncodedContext, err := SpanContextFromEncodedContext(tracedResource)
@@ -69,14 +72,32 @@ func SpanFromEncodedContext(tracedResource meta.Object, name string) (ctx contex
 		return context.Background(), &trace.Span{}, err
 	}
 
-	newCtx, newSpan := trace.StartSpanWithRemoteParent(context.Background(), name, spanFromEncodedContext)
+	sampler := currentConfig.SamplerForVerb(verb)
+	if currentConfig.ObjectSampler != nil {
+		sampler = currentConfig.ObjectSampler(tracedResource, sampler)
+	}
+	var opts []trace.StartOption
+	if sampler != nil {
+		opts = append(opts, trace.WithSampler(sampler))
+	}
+
+	newCtx, newSpan := trace.StartSpanWithRemoteParent(context.Background(), name, spanFromEncodedContext, opts...)
+	AnnotateSpan(newSpan, tracedResource, gvk, nil)
 	return newCtx, newSpan, nil
 }
 
-// SpanContextFromEncodedContext takes an object to extract an encoded SpanContext from and returns the decoded SpanContext
+// SpanContextFromEncodedContext takes an object to extract an encoded SpanContext from and returns the decoded
+// SpanContext. It accepts either a W3C traceparent string or the legacy base64(propagation.Binary(...)) format so
+// that objects written before the PropagationFormat rollout still decode correctly.
 func SpanContextFromEncodedContext(tracedResource meta.Object) (spanContext trace.SpanContext, err error) {
 
-	decodedContextBytes, err := base64.StdEncoding.DecodeString(tracedResource.GetTraceContext())
+	encoded := tracedResource.GetTraceContext()
+
+	if spanContext, err := SpanContextFromW3C(encoded); err == nil {
+		return spanContext, nil
+	}
+
+	decodedContextBytes, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return trace.SpanContext{}, err
 	}
@@ -90,12 +111,16 @@ func SpanContextFromEncodedContext(tracedResource meta.Object) (spanContext trac
 
 }
 
-// EncodeSpanContextIntoObject takes a pointer to an object and a trace context to embed
-// Base64 encodes the wire format for the SpanContext, and puts it in the object's TraceContext field
+// EncodeSpanContextIntoObject takes a pointer to an object and a trace context to embed, and puts it in the
+// object's TraceContext field encoded per CurrentPropagationFormat.
 func EncodeSpanContextIntoObject(tracedResource meta.Object, spanContext trace.SpanContext) error {
 
 	glog.Infof("encoding serialized SpanContext into object %s", tracedResource.GetName())
 
+	if CurrentPropagationFormat == FormatW3C {
+		return EncodeW3CIntoObject(tracedResource, spanContext)
+	}
+
 	rawContextBytes := propagation.Binary(spanContext)
 	encodedContext := base64.StdEncoding.EncodeToString(rawContextBytes)
 	tracedResource.SetTraceContext(encodedContext)
@@ -103,9 +128,14 @@ func EncodeSpanContextIntoObject(tracedResource meta.Object, spanContext trace.S
 	return nil
 }
 
-// EndRootObjectTraceWithName takes a traced resource, the final ServiceType, and the desired name
-// and exports the corresponding root span into the specified tracing backend
-func EndRootObjectTraceWithName(tracedResource meta.Object, service ServiceType, spanName string) {
+// EndRootObjectTraceWithName takes a traced resource, its GroupVersionKind, the final ServiceType, and the
+// desired name and exports the corresponding root span into the configured tracing backend
+func EndRootObjectTraceWithName(tracedResource meta.Object, gvk schema.GroupVersionKind, service ServiceType, spanName string) {
+
+	if currentExporter == nil {
+		glog.Errorf("cannot export root span for %s: no trace exporter has been initialized", tracedResource.GetName())
+		return
+	}
 
 	rootSpanContext, _ := SpanContextFromEncodedContext(tracedResource)
 	spanData := &trace.SpanData{
@@ -115,17 +145,21 @@ func EndRootObjectTraceWithName(tracedResource meta.Object, service ServiceType,
 		StartTime:    tracedResource.GetCreationTimestamp().Time,
 		EndTime:      time.Now(),
 		Status:       trace.Status{Code: trace.StatusCodeOK},
+		Attributes:   kubernetesAttributes(tracedResource, gvk, nil),
 	}
 
-	// Must create a separate Zipkin exporter here since it's not possible to access the global exporter directly
-	localEndpoint, err := openzipkin.NewEndpoint(string(service), fmt.Sprintf("%s:%s", DefaultTraceAddress, DefaultTracePort))
-	if err != nil {
-		log.Fatalf("Failed to create the local zipkinEndpoint: %v", err)
-	}
-	reporter := zipkinHTTP.NewReporter(DefaultCollectorAddress)
-	ze := zipkin.NewExporter(reporter, localEndpoint)
-	ze.ExportSpan(spanData)
+	currentExporter.ExportSpan(spanData)
+}
 
+// ExportSpanData forwards sd to the exporter most recently configured by InitializeExporter,
+// letting callers outside this package (e.g. the kubelet's in-cluster span receiver) reuse the
+// cluster-configured exporter instead of constructing their own.
+func ExportSpanData(sd *trace.SpanData) {
+	if currentExporter == nil {
+		glog.Errorf("cannot export span %s: no trace exporter has been initialized", sd.Name)
+		return
+	}
+	currentExporter.ExportSpan(sd)
 }
 
 // SpanContextToBase64String takes a SpanContext and returns a serialized string
@@ -0,0 +1,112 @@
+package traceutil
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.opencensus.io/trace"
+)
+
+// PropagationFormat selects the wire representation used to store a
+// SpanContext on an object's ObjectMeta.TraceContext field.
+type PropagationFormat string
+
+const (
+	// FormatW3C stores the context as a W3C Trace Context traceparent
+	// string, readable by any client without an OpenCensus dependency.
+	FormatW3C PropagationFormat = "w3c"
+	// FormatBinary stores the context as base64(propagation.Binary(...)),
+	// the original OpenCensus wire format. Kept for rollout compatibility.
+	FormatBinary PropagationFormat = "binary"
+
+	traceparentVersion = "00"
+)
+
+// CurrentPropagationFormat is the format EncodeSpanContextIntoObject writes.
+// SpanContextFromEncodedContext does not read it: it decodes either format,
+// trying W3C first. Defaults to FormatW3C; set to FormatBinary to keep emitting the legacy
+// format while consumers migrate.
+var CurrentPropagationFormat = FormatW3C
+
+// EncodeW3CIntoObject takes a pointer to an object and a trace context to embed,
+// and stores the W3C Trace Context traceparent string in the object's TraceContext field.
+func EncodeW3CIntoObject(tracedResource traceContextSetter, spanContext trace.SpanContext) error {
+	tracedResource.SetTraceContext(spanContextToTraceparent(spanContext))
+	return nil
+}
+
+// spanContextToTraceparent renders spanContext as a W3C traceparent string:
+// 00-<32 hex trace-id>-<16 hex span-id>-<2 hex flags>.
+func spanContextToTraceparent(spanContext trace.SpanContext) string {
+	flags := "00"
+	if spanContext.IsSampled() {
+		flags = "01"
+	}
+	return fmt.Sprintf("%s-%s-%s-%s",
+		traceparentVersion,
+		hex.EncodeToString(spanContext.TraceID[:]),
+		hex.EncodeToString(spanContext.SpanID[:]),
+		flags,
+	)
+}
+
+// SpanContextFromW3C parses a W3C traceparent string into a trace.SpanContext.
+// It validates the version and field lengths, rejects all-zero trace or span
+// IDs, and tolerates any extra fields appended after flags per the spec.
+func SpanContextFromW3C(traceparent string) (trace.SpanContext, error) {
+	fields := strings.Split(traceparent, "-")
+	if len(fields) < 4 {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: expected at least 4 fields, got %d", traceparent, len(fields))
+	}
+
+	version, traceIDHex, spanIDHex, flagsHex := fields[0], fields[1], fields[2], fields[3]
+	if version != traceparentVersion {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: unsupported version %q", traceparent, version)
+	}
+	if len(traceIDHex) != 32 {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: trace-id must be 32 hex chars, got %d", traceparent, len(traceIDHex))
+	}
+	if len(spanIDHex) != 16 {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: span-id must be 16 hex chars, got %d", traceparent, len(spanIDHex))
+	}
+	if len(flagsHex) != 2 {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: flags must be 2 hex chars, got %d", traceparent, len(flagsHex))
+	}
+
+	var spanContext trace.SpanContext
+	traceIDBytes, err := hex.DecodeString(traceIDHex)
+	if err != nil {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: invalid trace-id: %v", traceparent, err)
+	}
+	copy(spanContext.TraceID[:], traceIDBytes)
+	if spanContext.TraceID == (trace.TraceID{}) {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: trace-id must not be all zero", traceparent)
+	}
+
+	spanIDBytes, err := hex.DecodeString(spanIDHex)
+	if err != nil {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: invalid span-id: %v", traceparent, err)
+	}
+	copy(spanContext.SpanID[:], spanIDBytes)
+	if spanContext.SpanID == (trace.SpanID{}) {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: span-id must not be all zero", traceparent)
+	}
+
+	flagsByte, err := hex.DecodeString(flagsHex)
+	if err != nil {
+		return trace.SpanContext{}, fmt.Errorf("traceparent %q: invalid flags: %v", traceparent, err)
+	}
+	if flagsByte[0]&0x1 == 0x1 {
+		spanContext.TraceOptions = trace.TraceOptions(1)
+	}
+
+	return spanContext, nil
+}
+
+// traceContextSetter is the narrow slice of meta.Object used by
+// EncodeW3CIntoObject, kept separate so it can be satisfied by ObjectMeta
+// directly as well as meta.Object implementations.
+type traceContextSetter interface {
+	SetTraceContext(traceContext string)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracecontext implements an admission plugin that injects a Pod's ObjectMeta.TraceContext
+// into its containers, turning the manual env-reading pattern in the trace quickstart into a
+// zero-config capability for any workload that opts in.
+package tracecontext
+
+import (
+	"fmt"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apiserver/pkg/admission"
+	genericadmissioninit "k8s.io/apiserver/pkg/admission/initializer"
+	"k8s.io/client-go/informers"
+	traceutil "k8s.io/kubernetes/pkg/util/trace"
+)
+
+// PluginName is the name reported by RegisterAllAdmissionPlugins to enable this plugin via
+// --enable-admission-plugins.
+const PluginName = "PodTraceContextInjector"
+
+// OptInAnnotation on the Pod, or OptInLabel on its Namespace, enables injection for that Pod.
+// Injection defaults to off so clusters can roll it out gradually.
+const (
+	OptInAnnotation = "trace.kubernetes.io/inject"
+	OptInLabel      = "trace.kubernetes.io/inject"
+
+	envTraceContext = "KUBERNETES_TRACE_CONTEXT"
+	envTraceparent  = "TRACEPARENT"
+
+	volumeName = "kubernetes-trace-context"
+	volumeDir  = "/var/run/kubernetes/trace"
+	volumePath = volumeDir + "/context"
+)
+
+// Register registers this plugin with plugins so it can be enabled via --enable-admission-plugins.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return newPodTraceContextInjector(), nil
+	})
+}
+
+// podTraceContextInjector is a mutating admission plugin that injects a Pod's trace context into
+// its containers' environments (and optionally a projected volume) on CREATE.
+type podTraceContextInjector struct {
+	*admission.Handler
+
+	namespaceLister namespaceLister
+}
+
+// namespaceLister is the narrow slice of a NamespaceLister this plugin needs to read the
+// namespace-wide opt-in label.
+type namespaceLister interface {
+	Get(name string) (labels map[string]string, err error)
+}
+
+var _ admission.MutationInterface = &podTraceContextInjector{}
+var _ genericadmissioninit.WantsExternalKubeInformerFactory = &podTraceContextInjector{}
+
+func newPodTraceContextInjector() *podTraceContextInjector {
+	return &podTraceContextInjector{
+		Handler: admission.NewHandler(admission.Create),
+	}
+}
+
+// SetExternalKubeInformerFactory wires up the namespace informer used to read OptInLabel.
+func (p *podTraceContextInjector) SetExternalKubeInformerFactory(f informers.SharedInformerFactory) {
+	namespaces := f.Core().V1().Namespaces().Lister()
+	p.namespaceLister = namespaceListerFunc(func(name string) (map[string]string, error) {
+		ns, err := namespaces.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return ns.Labels, nil
+	})
+}
+
+// namespaceListerFunc adapts a plain function to the namespaceLister interface.
+type namespaceListerFunc func(name string) (map[string]string, error)
+
+func (f namespaceListerFunc) Get(name string) (map[string]string, error) { return f(name) }
+
+// Admit injects the Pod's trace context into every container when the Pod has opted in, either
+// directly via OptInAnnotation or by being created in a namespace labeled OptInLabel=true.
+func (p *podTraceContextInjector) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetResource().GroupResource().Resource != "pods" || len(a.GetSubresource()) != 0 {
+		return nil
+	}
+
+	pod, ok := a.GetObject().(*v1.Pod)
+	if !ok {
+		return fmt.Errorf("expected Pod but got %T", a.GetObject())
+	}
+
+	if pod.ObjectMeta.TraceContext == "" {
+		return nil
+	}
+
+	if !p.optedIn(pod) {
+		return nil
+	}
+
+	for i := range pod.Spec.Containers {
+		injectContainerEnv(&pod.Spec.Containers[i])
+	}
+
+	if _, ok := pod.Annotations["trace.kubernetes.io/skip-volume"]; !ok {
+		injectProjectedVolume(pod)
+	}
+
+	return nil
+}
+
+// optedIn reports whether pod should receive trace context injection, via its own annotation or
+// its namespace's label.
+func (p *podTraceContextInjector) optedIn(pod *v1.Pod) bool {
+	if pod.Annotations[OptInAnnotation] == "true" {
+		return true
+	}
+	if p.namespaceLister == nil {
+		return false
+	}
+	labels, err := p.namespaceLister.Get(pod.Namespace)
+	if err != nil {
+		return false
+	}
+	return labels[OptInLabel] == "true"
+}
+
+// injectContainerEnv adds KUBERNETES_TRACE_CONTEXT and TRACEPARENT env vars sourced from the
+// Pod's metadata.traceContext field, without clobbering env vars the container already defines.
+// TRACEPARENT is skipped when CurrentPropagationFormat is FormatBinary: metadata.traceContext
+// would then hold the legacy base64(OpenCensus binary) blob, not the W3C string TRACEPARENT
+// exists to give OTel SDKs, so injecting it would silently hand those SDKs garbage.
+func injectContainerEnv(c *v1.Container) {
+	names := []string{envTraceContext, envTraceparent}
+	if traceutil.CurrentPropagationFormat == traceutil.FormatBinary {
+		names = []string{envTraceContext}
+	}
+
+	for _, name := range names {
+		if hasEnv(c, name) {
+			continue
+		}
+		c.Env = append(c.Env, v1.EnvVar{
+			Name: name,
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{
+					FieldPath: "metadata.traceContext",
+				},
+			},
+		})
+	}
+}
+
+func hasEnv(c *v1.Container, name string) bool {
+	for _, e := range c.Env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// injectProjectedVolume adds a downward-API volume exposing metadata.traceContext at volumePath
+// in every container, and mounts it read-only.
+func injectProjectedVolume(pod *v1.Pod) {
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == volumeName {
+			return
+		}
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: volumeName,
+		VolumeSource: v1.VolumeSource{
+			DownwardAPI: &v1.DownwardAPIVolumeSource{
+				Items: []v1.DownwardAPIVolumeFile{
+					{
+						Path: "context",
+						FieldRef: &v1.ObjectFieldSelector{
+							FieldPath: "metadata.traceContext",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, v1.VolumeMount{
+			Name:      volumeName,
+			MountPath: volumeDir,
+			ReadOnly:  true,
+		})
+	}
+}
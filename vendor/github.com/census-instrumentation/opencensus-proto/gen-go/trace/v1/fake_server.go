@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// FakeTraceConfigServiceServer is an in-memory TraceConfigServiceServer for tests: it records the
+// CurrentLibraryConfig messages each connected client sends and lets a test Push
+// UpdatedLibraryConfig messages to them, all without a real network listener.
+type FakeTraceConfigServiceServer struct {
+	mu       sync.Mutex
+	conns    map[*fakeConn]struct{}
+	received []*CurrentLibraryConfig
+}
+
+// NewFakeTraceConfigServiceServer returns an empty FakeTraceConfigServiceServer.
+func NewFakeTraceConfigServiceServer() *FakeTraceConfigServiceServer {
+	return &FakeTraceConfigServiceServer{conns: map[*fakeConn]struct{}{}}
+}
+
+// Dial returns a TraceConfigServiceClient wired directly to f, so a Watcher can be driven against
+// f in tests without a real gRPC connection.
+func (f *FakeTraceConfigServiceServer) Dial() TraceConfigServiceClient {
+	return &fakeClient{server: f}
+}
+
+// Received returns the CurrentLibraryConfig messages received so far, in arrival order.
+func (f *FakeTraceConfigServiceServer) Received() []*CurrentLibraryConfig {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]*CurrentLibraryConfig, len(f.received))
+	copy(out, f.received)
+	return out
+}
+
+// Push sends cfg to every client currently connected to f.
+func (f *FakeTraceConfigServiceServer) Push(cfg *TraceConfig) {
+	f.mu.Lock()
+	conns := make([]*fakeConn, 0, len(f.conns))
+	for c := range f.conns {
+		conns = append(conns, c)
+	}
+	f.mu.Unlock()
+
+	for _, c := range conns {
+		c.toClient <- &UpdatedLibraryConfig{Config: cfg}
+	}
+}
+
+func (f *FakeTraceConfigServiceServer) serve(c *fakeConn) {
+	f.mu.Lock()
+	f.conns[c] = struct{}{}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		delete(f.conns, c)
+		f.mu.Unlock()
+	}()
+
+	for cfg := range c.toServer {
+		f.mu.Lock()
+		f.received = append(f.received, cfg)
+		f.mu.Unlock()
+	}
+}
+
+type fakeClient struct {
+	server *FakeTraceConfigServiceServer
+}
+
+func (c *fakeClient) Config(ctx context.Context, _ ...grpc.CallOption) (TraceConfigService_ConfigClient, error) {
+	conn := &fakeConn{
+		ctx:      ctx,
+		toServer: make(chan *CurrentLibraryConfig, 1),
+		toClient: make(chan *UpdatedLibraryConfig, 1),
+	}
+	go c.server.serve(conn)
+	go func() {
+		<-ctx.Done()
+		close(conn.toServer)
+	}()
+	return conn, nil
+}
+
+// fakeConn implements TraceConfigService_ConfigClient directly over a pair of channels, so tests
+// can exercise Watcher against FakeTraceConfigServiceServer without real gRPC transport.
+type fakeConn struct {
+	ctx      context.Context
+	toServer chan *CurrentLibraryConfig
+	toClient chan *UpdatedLibraryConfig
+}
+
+func (c *fakeConn) Send(m *CurrentLibraryConfig) error {
+	select {
+	case c.toServer <- m:
+		return nil
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+func (c *fakeConn) Recv() (*UpdatedLibraryConfig, error) {
+	select {
+	case m, ok := <-c.toClient:
+		if !ok {
+			return nil, io.EOF
+		}
+		return m, nil
+	case <-c.ctx.Done():
+		return nil, c.ctx.Err()
+	}
+}
+
+func (c *fakeConn) Header() (metadata.MD, error) { return nil, nil }
+func (c *fakeConn) Trailer() metadata.MD         { return nil }
+func (c *fakeConn) CloseSend() error             { return nil }
+func (c *fakeConn) Context() context.Context     { return c.ctx }
+func (c *fakeConn) SendMsg(m interface{}) error  { return nil }
+func (c *fakeConn) RecvMsg(m interface{}) error  { return nil }
@@ -0,0 +1,67 @@
+package v1
+
+import "testing"
+
+func alwaysSampleConfig() *TraceConfig {
+	return &TraceConfig{Sampler: &TraceConfig_ConstantSampler{ConstantSampler: &ConstantSampler{Decision: true}}}
+}
+
+func neverSampleConfig() *TraceConfig {
+	return &TraceConfig{Sampler: &TraceConfig_ConstantSampler{ConstantSampler: &ConstantSampler{Decision: false}}}
+}
+
+func TestParentBasedSamplerQuadrants(t *testing.T) {
+	// Each quadrant (and root) gets a distinct always/never ConstantSampler so a wrong lookup in
+	// next() is caught by a mismatched decision rather than two quadrants coincidentally agreeing.
+	cfg := &TraceConfig{
+		Sampler: &TraceConfig_ParentBasedSampler{
+			ParentBasedSampler: &ParentBasedSampler{
+				Root:                   neverSampleConfig(),
+				RemoteParentSampled:    alwaysSampleConfig(),
+				RemoteParentNotSampled: neverSampleConfig(),
+				LocalParentSampled:     alwaysSampleConfig(),
+				LocalParentNotSampled:  neverSampleConfig(),
+			},
+		},
+	}
+
+	var traceID [16]byte
+	tests := []struct {
+		name   string
+		parent ParentContext
+		want   bool
+	}{
+		{"noParent", ParentContext{HasParent: false}, false},
+		{"remoteSampled", ParentContext{HasParent: true, IsRemote: true, Sampled: true}, true},
+		{"remoteNotSampled", ParentContext{HasParent: true, IsRemote: true, Sampled: false}, false},
+		{"localSampled", ParentContext{HasParent: true, IsRemote: false, Sampled: true}, true},
+		{"localNotSampled", ParentContext{HasParent: true, IsRemote: false, Sampled: false}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cfg.Decide(tt.parent, traceID)
+			if err != nil {
+				t.Fatalf("Decide: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Decide(%+v) = %v, want %v", tt.parent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParentBasedSamplerMaxDepth(t *testing.T) {
+	// A ParentBasedSampler whose Root points back at itself must not recurse forever: Decide
+	// should give up once maxSamplerDepth is exceeded and report an error instead of hanging.
+	cyclic := &TraceConfig{}
+	cyclic.Sampler = &TraceConfig_ParentBasedSampler{
+		ParentBasedSampler: &ParentBasedSampler{Root: cyclic},
+	}
+
+	var traceID [16]byte
+	_, err := cyclic.Decide(ParentContext{HasParent: false}, traceID)
+	if err == nil {
+		t.Fatal("Decide on a self-referential ParentBasedSampler: got nil error, want a max-depth error")
+	}
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"sync"
+	"time"
+)
+
+// PerOperationDecider applies a PerOperationSampler's strategy, holding the per-operation
+// guaranteed-throughput token buckets that a stateless TraceConfig.Decide call can't carry across
+// invocations. Mirrors Jaeger's adaptive sampling model: an operation samples if the probabilistic
+// check passes OR a token is available from its guaranteed-throughput bucket, so rare operations
+// still get at least the configured lower bound of traces/sec while hot operations sample near
+// their configured probability.
+type PerOperationDecider struct {
+	cfg *PerOperationSampler
+
+	mu      sync.Mutex
+	buckets map[string]*operationBucket
+}
+
+// NewPerOperationDecider builds a PerOperationDecider from cfg.
+func NewPerOperationDecider(cfg *PerOperationSampler) *PerOperationDecider {
+	return &PerOperationDecider{cfg: cfg, buckets: map[string]*operationBucket{}}
+}
+
+// Decide reports whether a span named operation, with the given traceID, should be sampled.
+func (d *PerOperationDecider) Decide(operation string, traceID [16]byte) bool {
+	probability := d.cfg.GetDefaultSamplingProbability()
+	lowerBound := d.cfg.GetDefaultLowerBoundTracesPerSecond()
+
+	for _, strategy := range d.cfg.GetPerOperationStrategies() {
+		if strategy.GetOperation() == operation {
+			probability = strategy.GetProbabilitySampler().GetSamplingProbability()
+			break
+		}
+	}
+
+	if decideProbability(probability, traceID) {
+		return true
+	}
+	if lowerBound <= 0 {
+		return false
+	}
+	return d.bucketFor(operation, lowerBound).take()
+}
+
+func (d *PerOperationDecider) bucketFor(operation string, lowerBound float64) *operationBucket {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, ok := d.buckets[operation]
+	if !ok {
+		b = &operationBucket{capacity: lowerBound, tokens: lowerBound}
+		d.buckets[operation] = b
+	}
+	return b
+}
+
+// operationBucket is a token bucket refilling at capacity tokens/sec (capacity ==
+// lower_bound_traces_per_second), giving an operation a guaranteed minimum sampling rate
+// independent of its probabilistic outcome.
+type operationBucket struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func (b *operationBucket) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if !b.lastRefill.IsZero() {
+		b.tokens += b.capacity * now.Sub(b.lastRefill).Seconds()
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.lastRefill = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,69 @@
+package v1
+
+import "testing"
+
+// traceIDWithLowBytes builds a traceID whose low 8 bytes (the ones decideProbability compares
+// against the sampling threshold) equal v.
+func traceIDWithLowBytes(v uint64) [16]byte {
+	var id [16]byte
+	for i := 0; i < 8; i++ {
+		id[15-i] = byte(v >> (8 * i))
+	}
+	return id
+}
+
+func TestPerOperationDeciderGuaranteedFloor(t *testing.T) {
+	// A rare operation with zero sampling probability must still be sampled at roughly the
+	// guaranteed lower-bound rate, via the token bucket, not the (always-false) probability check.
+	d := NewPerOperationDecider(&PerOperationSampler{
+		DefaultSamplingProbability:       0,
+		DefaultLowerBoundTracesPerSecond: 5,
+	})
+
+	sampled := 0
+	for i := 0; i < 5; i++ {
+		if d.Decide("rare-op", traceIDWithLowBytes(uint64(i))) {
+			sampled++
+		}
+	}
+	if sampled != 5 {
+		t.Errorf("sampled %d/5 calls within the guaranteed floor, want all 5 (bucket starts full at capacity=5)", sampled)
+	}
+
+	// Once the bucket is drained, with zero probability, the immediate next call should not
+	// sample (no time has passed to refill).
+	if d.Decide("rare-op", traceIDWithLowBytes(5)) {
+		t.Errorf("Decide sampled immediately after draining the guaranteed-throughput bucket with zero probability")
+	}
+}
+
+func TestPerOperationDeciderNearProbability(t *testing.T) {
+	// A hot operation configured at a high sampling probability, with no guaranteed floor, should
+	// sample close to its configured probability and not rely on the (disabled) token bucket.
+	d := NewPerOperationDecider(&PerOperationSampler{
+		DefaultSamplingProbability:       0.9,
+		DefaultLowerBoundTracesPerSecond: 0,
+		PerOperationStrategies: []*OperationSamplingStrategy{
+			{
+				Operation:          "hot-op",
+				ProbabilitySampler: &ProbabilitySampler{SamplingProbability: 0.9},
+			},
+		},
+	})
+
+	const n = 1000
+	sampled := 0
+	for i := 0; i < n; i++ {
+		// Multiplying by an odd golden-ratio-derived constant and letting it wrap mod 2^64
+		// (Fibonacci hashing) spreads consecutive i across the full uint64 range pseudo-randomly.
+		v := uint64(i) * 0x9E3779B97F4A7C15
+		if d.Decide("hot-op", traceIDWithLowBytes(v)) {
+			sampled++
+		}
+	}
+
+	rate := float64(sampled) / n
+	if rate < 0.8 || rate > 1.0 {
+		t.Errorf("sampled rate = %v over %d calls, want close to the configured 0.9 probability", rate, n)
+	}
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// maxSamplerDepth bounds how many nested ParentBasedSampler.root (and sibling) hops Decide will
+// follow before giving up, so a config that nests a ParentBasedSampler inside itself cannot make
+// Decide recurse forever.
+const maxSamplerDepth = 32
+
+// ParentContext describes the SpanContext a sampling decision is being made relative to, as seen
+// by ParentBasedSampler.
+type ParentContext struct {
+	// HasParent is false for root spans, in which case ParentBasedSampler falls back to Root.
+	HasParent bool
+	// IsRemote is true when the parent SpanContext arrived over the wire (e.g. via
+	// ObjectMeta.TraceContext) rather than from a local parent span.
+	IsRemote bool
+	// Sampled is the parent SpanContext's sampled bit.
+	Sampled bool
+}
+
+// Decide walks the Sampler configured on cfg and returns whether traceID should be sampled given
+// parent. It returns an error if the configuration nests ParentBasedSampler more than
+// maxSamplerDepth deep.
+func (cfg *TraceConfig) Decide(parent ParentContext, traceID [16]byte) (bool, error) {
+	return cfg.decide(parent, traceID, 0)
+}
+
+func (cfg *TraceConfig) decide(parent ParentContext, traceID [16]byte, depth int) (bool, error) {
+	if cfg == nil {
+		return false, nil
+	}
+	if depth > maxSamplerDepth {
+		return false, fmt.Errorf("opencensus.proto.trace.v1.TraceConfig: ParentBasedSampler nesting exceeds max depth %d", maxSamplerDepth)
+	}
+
+	switch s := cfg.GetSampler().(type) {
+	case *TraceConfig_ProbabilitySampler:
+		return decideProbability(s.ProbabilitySampler.GetSamplingProbability(), traceID), nil
+	case *TraceConfig_ConstantSampler:
+		return s.ConstantSampler.GetDecision(), nil
+	case *TraceConfig_RateLimitingSampler:
+		// Rate limiting is stateful per-process; a TraceConfig alone can't decide it, so the
+		// caller's exporter-side rate limiter (see traceutil.RateLimitedSampler) applies it.
+		return true, nil
+	case *TraceConfig_ParentBasedSampler:
+		next := s.ParentBasedSampler.next(parent)
+		if next == nil && parent.HasParent {
+			// No sampler is configured for this parent quadrant: honor the parent's sampled bit.
+			return parent.Sampled, nil
+		}
+		return next.decide(parent, traceID, depth+1)
+	case *TraceConfig_PerOperationSampler:
+		// PerOperationSampler's guaranteed-throughput bucket is per-process state that a pure
+		// TraceConfig can't hold; callers needing that behavior should use
+		// NewPerOperationDecider(s.PerOperationSampler) instead of Decide.
+		return decideProbability(s.PerOperationSampler.GetDefaultSamplingProbability(), traceID), nil
+	default:
+		return false, nil
+	}
+}
+
+// next selects the nested TraceConfig to delegate to for the given parent state: Root when there
+// is no parent, otherwise whichever of the four parent quadrants matches.
+func (m *ParentBasedSampler) next(parent ParentContext) *TraceConfig {
+	if !parent.HasParent {
+		return m.GetRoot()
+	}
+	switch {
+	case parent.IsRemote && parent.Sampled:
+		return m.GetRemoteParentSampled()
+	case parent.IsRemote && !parent.Sampled:
+		return m.GetRemoteParentNotSampled()
+	case !parent.IsRemote && parent.Sampled:
+		return m.GetLocalParentSampled()
+	default:
+		return m.GetLocalParentNotSampled()
+	}
+}
+
+// decideProbability samples traceID deterministically against probability by treating its low 8
+// bytes as a uniformly distributed uint64.
+func decideProbability(probability float64, traceID [16]byte) bool {
+	if probability <= 0 {
+		return false
+	}
+	if probability >= 1 {
+		return true
+	}
+	threshold := uint64(probability * float64(1<<63))
+	return binary.BigEndian.Uint64(traceID[8:16])>>1 < threshold
+}
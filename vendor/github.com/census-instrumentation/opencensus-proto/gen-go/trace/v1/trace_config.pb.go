@@ -1,60 +1,117 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
 // source: opencensus/proto/trace/v1/trace_config.proto
 
 package v1
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
-
-// Global configuration of the trace service.
+import (
+	protoadapt "google.golang.org/protobuf/protoadapt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Global configuration of the trace service. All fields must be optional.
 type TraceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	// The global default sampler used to make decisions on span sampling.
 	//
-	// Types that are valid to be assigned to Sampler:
+	// Types that are assignable to Sampler:
+	//
 	//	*TraceConfig_ProbabilitySampler
 	//	*TraceConfig_ConstantSampler
 	//	*TraceConfig_RateLimitingSampler
-	Sampler              isTraceConfig_Sampler `protobuf_oneof:"sampler"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	//	*TraceConfig_ParentBasedSampler
+	//	*TraceConfig_PerOperationSampler
+	Sampler isTraceConfig_Sampler `protobuf_oneof:"sampler"`
+}
+
+func (x *TraceConfig) Reset() {
+	*x = TraceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
-func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
-func (*TraceConfig) ProtoMessage()    {}
+func (*TraceConfig) ProtoMessage() {}
+
+func (x *TraceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trace_config_f3e6892b10e0734b, []int{0}
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{0}
 }
-func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TraceConfig.Unmarshal(m, b)
+
+func (m *TraceConfig) GetSampler() isTraceConfig_Sampler {
+	if m != nil {
+		return m.Sampler
+	}
+	return nil
 }
-func (m *TraceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TraceConfig.Marshal(b, m, deterministic)
+
+func (x *TraceConfig) GetProbabilitySampler() *ProbabilitySampler {
+	if x, ok := x.GetSampler().(*TraceConfig_ProbabilitySampler); ok {
+		return x.ProbabilitySampler
+	}
+	return nil
 }
-func (dst *TraceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TraceConfig.Merge(dst, src)
+
+func (x *TraceConfig) GetConstantSampler() *ConstantSampler {
+	if x, ok := x.GetSampler().(*TraceConfig_ConstantSampler); ok {
+		return x.ConstantSampler
+	}
+	return nil
 }
-func (m *TraceConfig) XXX_Size() int {
-	return xxx_messageInfo_TraceConfig.Size(m)
+
+func (x *TraceConfig) GetRateLimitingSampler() *RateLimitingSampler {
+	if x, ok := x.GetSampler().(*TraceConfig_RateLimitingSampler); ok {
+		return x.RateLimitingSampler
+	}
+	return nil
 }
-func (m *TraceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_TraceConfig.DiscardUnknown(m)
+
+func (x *TraceConfig) GetParentBasedSampler() *ParentBasedSampler {
+	if x, ok := x.GetSampler().(*TraceConfig_ParentBasedSampler); ok {
+		return x.ParentBasedSampler
+	}
+	return nil
 }
 
-var xxx_messageInfo_TraceConfig proto.InternalMessageInfo
+func (x *TraceConfig) GetPerOperationSampler() *PerOperationSampler {
+	if x, ok := x.GetSampler().(*TraceConfig_PerOperationSampler); ok {
+		return x.PerOperationSampler
+	}
+	return nil
+}
 
 type isTraceConfig_Sampler interface {
 	isTraceConfig_Sampler()
@@ -72,287 +129,570 @@ type TraceConfig_RateLimitingSampler struct {
 	RateLimitingSampler *RateLimitingSampler `protobuf:"bytes,3,opt,name=rate_limiting_sampler,json=rateLimitingSampler,proto3,oneof"`
 }
 
+type TraceConfig_ParentBasedSampler struct {
+	ParentBasedSampler *ParentBasedSampler `protobuf:"bytes,4,opt,name=parent_based_sampler,json=parentBasedSampler,proto3,oneof"`
+}
+
+type TraceConfig_PerOperationSampler struct {
+	PerOperationSampler *PerOperationSampler `protobuf:"bytes,5,opt,name=per_operation_sampler,json=perOperationSampler,proto3,oneof"`
+}
+
 func (*TraceConfig_ProbabilitySampler) isTraceConfig_Sampler() {}
 
 func (*TraceConfig_ConstantSampler) isTraceConfig_Sampler() {}
 
 func (*TraceConfig_RateLimitingSampler) isTraceConfig_Sampler() {}
 
-func (m *TraceConfig) GetSampler() isTraceConfig_Sampler {
-	if m != nil {
-		return m.Sampler
-	}
-	return nil
+func (*TraceConfig_ParentBasedSampler) isTraceConfig_Sampler() {}
+
+func (*TraceConfig_PerOperationSampler) isTraceConfig_Sampler() {}
+
+// Sampler that tries to uniformly sample traces with a given probability.
+// The probability of sampling a trace is equal to that of the specified
+// probability.
+type ProbabilitySampler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The desired probability of sampling. Must be within [0.0, 1.0].
+	SamplingProbability float64 `protobuf:"fixed64,1,opt,name=samplingProbability,proto3" json:"samplingProbability,omitempty"`
 }
 
-func (m *TraceConfig) GetProbabilitySampler() *ProbabilitySampler {
-	if x, ok := m.GetSampler().(*TraceConfig_ProbabilitySampler); ok {
-		return x.ProbabilitySampler
+func (x *ProbabilitySampler) Reset() {
+	*x = ProbabilitySampler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (m *TraceConfig) GetConstantSampler() *ConstantSampler {
-	if x, ok := m.GetSampler().(*TraceConfig_ConstantSampler); ok {
-		return x.ConstantSampler
+func (x *ProbabilitySampler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbabilitySampler) ProtoMessage() {}
+
+func (x *ProbabilitySampler) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (m *TraceConfig) GetRateLimitingSampler() *RateLimitingSampler {
-	if x, ok := m.GetSampler().(*TraceConfig_RateLimitingSampler); ok {
-		return x.RateLimitingSampler
+// Deprecated: Use ProbabilitySampler.ProtoReflect.Descriptor instead.
+func (*ProbabilitySampler) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProbabilitySampler) GetSamplingProbability() float64 {
+	if x != nil {
+		return x.SamplingProbability
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*TraceConfig) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _TraceConfig_OneofMarshaler, _TraceConfig_OneofUnmarshaler, _TraceConfig_OneofSizer, []interface{}{
-		(*TraceConfig_ProbabilitySampler)(nil),
-		(*TraceConfig_ConstantSampler)(nil),
-		(*TraceConfig_RateLimitingSampler)(nil),
+// Sampler that makes a constant decision (either always "yes" or always
+// "no") on span sampling.
+type ConstantSampler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether spans should be always sampled, or never sampled.
+	Decision bool `protobuf:"varint,1,opt,name=decision,proto3" json:"decision,omitempty"`
+}
+
+func (x *ConstantSampler) Reset() {
+	*x = ConstantSampler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-func _TraceConfig_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*TraceConfig)
-	// sampler
-	switch x := m.Sampler.(type) {
-	case *TraceConfig_ProbabilitySampler:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ProbabilitySampler); err != nil {
-			return err
-		}
-	case *TraceConfig_ConstantSampler:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ConstantSampler); err != nil {
-			return err
-		}
-	case *TraceConfig_RateLimitingSampler:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.RateLimitingSampler); err != nil {
-			return err
+func (x *ConstantSampler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstantSampler) ProtoMessage() {}
+
+func (x *ConstantSampler) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-	case nil:
-	default:
-		return fmt.Errorf("TraceConfig.Sampler has unexpected type %T", x)
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func _TraceConfig_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*TraceConfig)
-	switch tag {
-	case 1: // sampler.probability_sampler
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ProbabilitySampler)
-		err := b.DecodeMessage(msg)
-		m.Sampler = &TraceConfig_ProbabilitySampler{msg}
-		return true, err
-	case 2: // sampler.constant_sampler
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConstantSampler)
-		err := b.DecodeMessage(msg)
-		m.Sampler = &TraceConfig_ConstantSampler{msg}
-		return true, err
-	case 3: // sampler.rate_limiting_sampler
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(RateLimitingSampler)
-		err := b.DecodeMessage(msg)
-		m.Sampler = &TraceConfig_RateLimitingSampler{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _TraceConfig_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*TraceConfig)
-	// sampler
-	switch x := m.Sampler.(type) {
-	case *TraceConfig_ProbabilitySampler:
-		s := proto.Size(x.ProbabilitySampler)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *TraceConfig_ConstantSampler:
-		s := proto.Size(x.ConstantSampler)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *TraceConfig_RateLimitingSampler:
-		s := proto.Size(x.RateLimitingSampler)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+// Deprecated: Use ConstantSampler.ProtoReflect.Descriptor instead.
+func (*ConstantSampler) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{2}
 }
 
-// Sampler that tries to uniformly sample traces with a given probability.
-// The probability of sampling a trace is equal to that of the specified probability.
-type ProbabilitySampler struct {
-	// The desired probability of sampling. Must be within [0.0, 1.0].
-	SamplingProbability  float64  `protobuf:"fixed64,1,opt,name=samplingProbability,proto3" json:"samplingProbability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *ConstantSampler) GetDecision() bool {
+	if x != nil {
+		return x.Decision
+	}
+	return false
 }
 
-func (m *ProbabilitySampler) Reset()         { *m = ProbabilitySampler{} }
-func (m *ProbabilitySampler) String() string { return proto.CompactTextString(m) }
-func (*ProbabilitySampler) ProtoMessage()    {}
-func (*ProbabilitySampler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trace_config_f3e6892b10e0734b, []int{1}
+// Sampler that tries to sample with a rate per time window.
+type RateLimitingSampler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rate per second.
+	Qps int64 `protobuf:"varint,1,opt,name=qps,proto3" json:"qps,omitempty"`
 }
-func (m *ProbabilitySampler) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProbabilitySampler.Unmarshal(m, b)
+
+func (x *RateLimitingSampler) Reset() {
+	*x = RateLimitingSampler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *ProbabilitySampler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProbabilitySampler.Marshal(b, m, deterministic)
+
+func (x *RateLimitingSampler) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (dst *ProbabilitySampler) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProbabilitySampler.Merge(dst, src)
+
+func (*RateLimitingSampler) ProtoMessage() {}
+
+func (x *RateLimitingSampler) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *ProbabilitySampler) XXX_Size() int {
-	return xxx_messageInfo_ProbabilitySampler.Size(m)
+
+// Deprecated: Use RateLimitingSampler.ProtoReflect.Descriptor instead.
+func (*RateLimitingSampler) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{3}
 }
-func (m *ProbabilitySampler) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProbabilitySampler.DiscardUnknown(m)
+
+func (x *RateLimitingSampler) GetQps() int64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
 }
 
-var xxx_messageInfo_ProbabilitySampler proto.InternalMessageInfo
+// Sampler that honors the sampled bit of an incoming parent SpanContext, and falls back to a
+// nested root Sampler when there is no parent. See TraceConfig.Decide for the decision algorithm.
+type ParentBasedSampler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sampler used when there is no parent SpanContext.
+	Root *TraceConfig `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Sampler used when the parent is remote and sampled.
+	RemoteParentSampled *TraceConfig `protobuf:"bytes,2,opt,name=remote_parent_sampled,json=remoteParentSampled,proto3" json:"remote_parent_sampled,omitempty"`
+	// Sampler used when the parent is remote and not sampled.
+	RemoteParentNotSampled *TraceConfig `protobuf:"bytes,3,opt,name=remote_parent_not_sampled,json=remoteParentNotSampled,proto3" json:"remote_parent_not_sampled,omitempty"`
+	// Sampler used when the parent is local and sampled.
+	LocalParentSampled *TraceConfig `protobuf:"bytes,4,opt,name=local_parent_sampled,json=localParentSampled,proto3" json:"local_parent_sampled,omitempty"`
+	// Sampler used when the parent is local and not sampled.
+	LocalParentNotSampled *TraceConfig `protobuf:"bytes,5,opt,name=local_parent_not_sampled,json=localParentNotSampled,proto3" json:"local_parent_not_sampled,omitempty"`
+}
+
+func (x *ParentBasedSampler) Reset() {
+	*x = ParentBasedSampler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (m *ProbabilitySampler) GetSamplingProbability() float64 {
-	if m != nil {
-		return m.SamplingProbability
+func (x *ParentBasedSampler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentBasedSampler) ProtoMessage() {}
+
+func (x *ParentBasedSampler) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// Sampler that makes a constant decision (either always "yes" or always "no")
-// on span sampling.
-type ConstantSampler struct {
-	// Whether spans should be always sampled, or never sampled.
-	Decision             bool     `protobuf:"varint,1,opt,name=decision,proto3" json:"decision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use ParentBasedSampler.ProtoReflect.Descriptor instead.
+func (*ParentBasedSampler) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{4}
 }
 
-func (m *ConstantSampler) Reset()         { *m = ConstantSampler{} }
-func (m *ConstantSampler) String() string { return proto.CompactTextString(m) }
-func (*ConstantSampler) ProtoMessage()    {}
-func (*ConstantSampler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trace_config_f3e6892b10e0734b, []int{2}
+func (x *ParentBasedSampler) GetRoot() *TraceConfig {
+	if x != nil {
+		return x.Root
+	}
+	return nil
 }
-func (m *ConstantSampler) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConstantSampler.Unmarshal(m, b)
+
+func (x *ParentBasedSampler) GetRemoteParentSampled() *TraceConfig {
+	if x != nil {
+		return x.RemoteParentSampled
+	}
+	return nil
 }
-func (m *ConstantSampler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConstantSampler.Marshal(b, m, deterministic)
+
+func (x *ParentBasedSampler) GetRemoteParentNotSampled() *TraceConfig {
+	if x != nil {
+		return x.RemoteParentNotSampled
+	}
+	return nil
 }
-func (dst *ConstantSampler) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConstantSampler.Merge(dst, src)
+
+func (x *ParentBasedSampler) GetLocalParentSampled() *TraceConfig {
+	if x != nil {
+		return x.LocalParentSampled
+	}
+	return nil
 }
-func (m *ConstantSampler) XXX_Size() int {
-	return xxx_messageInfo_ConstantSampler.Size(m)
+
+func (x *ParentBasedSampler) GetLocalParentNotSampled() *TraceConfig {
+	if x != nil {
+		return x.LocalParentNotSampled
+	}
+	return nil
 }
-func (m *ConstantSampler) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConstantSampler.DiscardUnknown(m)
+
+// Sampler that applies a per-operation strategy by span name, falling back to defaults for
+// operations with no specific strategy. See PerOperationDecider.Decide for the decision algorithm.
+type PerOperationSampler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default sampling probability applied to operations with no specific strategy.
+	DefaultSamplingProbability float64 `protobuf:"fixed64,1,opt,name=default_sampling_probability,json=defaultSamplingProbability,proto3" json:"default_sampling_probability,omitempty"`
+	// Guaranteed minimum traces/sec sampled per operation, regardless of probability.
+	DefaultLowerBoundTracesPerSecond float64 `protobuf:"fixed64,2,opt,name=default_lower_bound_traces_per_second,json=defaultLowerBoundTracesPerSecond,proto3" json:"default_lower_bound_traces_per_second,omitempty"`
+	// Per-operation overrides, matched against the span name.
+	PerOperationStrategies []*OperationSamplingStrategy `protobuf:"bytes,3,rep,name=per_operation_strategies,json=perOperationStrategies,proto3" json:"per_operation_strategies,omitempty"`
+}
+
+func (x *PerOperationSampler) Reset() {
+	*x = PerOperationSampler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var xxx_messageInfo_ConstantSampler proto.InternalMessageInfo
+func (x *PerOperationSampler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (m *ConstantSampler) GetDecision() bool {
-	if m != nil {
-		return m.Decision
+func (*PerOperationSampler) ProtoMessage() {}
+
+func (x *PerOperationSampler) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// Sampler that tries to sample with a rate per time window.
-type RateLimitingSampler struct {
-	// Rate per second.
-	Qps                  int64    `protobuf:"varint,1,opt,name=qps,proto3" json:"qps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use PerOperationSampler.ProtoReflect.Descriptor instead.
+func (*PerOperationSampler) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{5}
 }
 
-func (m *RateLimitingSampler) Reset()         { *m = RateLimitingSampler{} }
-func (m *RateLimitingSampler) String() string { return proto.CompactTextString(m) }
-func (*RateLimitingSampler) ProtoMessage()    {}
-func (*RateLimitingSampler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trace_config_f3e6892b10e0734b, []int{3}
+func (x *PerOperationSampler) GetDefaultSamplingProbability() float64 {
+	if x != nil {
+		return x.DefaultSamplingProbability
+	}
+	return 0
+}
+
+func (x *PerOperationSampler) GetDefaultLowerBoundTracesPerSecond() float64 {
+	if x != nil {
+		return x.DefaultLowerBoundTracesPerSecond
+	}
+	return 0
+}
+
+func (x *PerOperationSampler) GetPerOperationStrategies() []*OperationSamplingStrategy {
+	if x != nil {
+		return x.PerOperationStrategies
+	}
+	return nil
 }
-func (m *RateLimitingSampler) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RateLimitingSampler.Unmarshal(m, b)
+
+// A single operation's sampling strategy, as used by PerOperationSampler.
+type OperationSamplingStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// operation is matched against the span name.
+	Operation          string              `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	ProbabilitySampler *ProbabilitySampler `protobuf:"bytes,2,opt,name=probability_sampler,json=probabilitySampler,proto3" json:"probability_sampler,omitempty"`
 }
-func (m *RateLimitingSampler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RateLimitingSampler.Marshal(b, m, deterministic)
+
+func (x *OperationSamplingStrategy) Reset() {
+	*x = OperationSamplingStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (dst *RateLimitingSampler) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RateLimitingSampler.Merge(dst, src)
+
+func (x *OperationSamplingStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *RateLimitingSampler) XXX_Size() int {
-	return xxx_messageInfo_RateLimitingSampler.Size(m)
+
+func (*OperationSamplingStrategy) ProtoMessage() {}
+
+func (x *OperationSamplingStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *RateLimitingSampler) XXX_DiscardUnknown() {
-	xxx_messageInfo_RateLimitingSampler.DiscardUnknown(m)
+
+// Deprecated: Use OperationSamplingStrategy.ProtoReflect.Descriptor instead.
+func (*OperationSamplingStrategy) Descriptor() ([]byte, []int) {
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP(), []int{6}
 }
 
-var xxx_messageInfo_RateLimitingSampler proto.InternalMessageInfo
+func (x *OperationSamplingStrategy) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
 
-func (m *RateLimitingSampler) GetQps() int64 {
-	if m != nil {
-		return m.Qps
+func (x *OperationSamplingStrategy) GetProbabilitySampler() *ProbabilitySampler {
+	if x != nil {
+		return x.ProbabilitySampler
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*TraceConfig)(nil), "opencensus.proto.trace.v1.TraceConfig")
-	proto.RegisterType((*ProbabilitySampler)(nil), "opencensus.proto.trace.v1.ProbabilitySampler")
-	proto.RegisterType((*ConstantSampler)(nil), "opencensus.proto.trace.v1.ConstantSampler")
-	proto.RegisterType((*RateLimitingSampler)(nil), "opencensus.proto.trace.v1.RateLimitingSampler")
-}
-
-func init() {
-	proto.RegisterFile("opencensus/proto/trace/v1/trace_config.proto", fileDescriptor_trace_config_f3e6892b10e0734b)
-}
-
-var fileDescriptor_trace_config_f3e6892b10e0734b = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x5d, 0x4b, 0xf3, 0x30,
-	0x14, 0xc7, 0x9f, 0x6e, 0xf0, 0x38, 0xcf, 0x2e, 0x36, 0x52, 0x04, 0x15, 0x2f, 0xa4, 0x37, 0x8a,
-	0xd8, 0xd4, 0xe9, 0x37, 0xe8, 0x40, 0xbc, 0xf0, 0x62, 0x54, 0x41, 0xf0, 0x66, 0xa6, 0x59, 0xac,
-	0x07, 0xd6, 0xa4, 0x26, 0x67, 0x03, 0x3f, 0x9a, 0xdf, 0x4e, 0x96, 0x8e, 0x75, 0xba, 0x97, 0xbb,
-	0xe4, 0xff, 0xf2, 0x6b, 0x4f, 0x7b, 0xe0, 0xda, 0x54, 0x4a, 0x4b, 0xa5, 0xdd, 0xcc, 0x25, 0x95,
-	0x35, 0x64, 0x12, 0xb2, 0x42, 0xaa, 0x64, 0x3e, 0xa8, 0x0f, 0x63, 0x69, 0xf4, 0x3b, 0x16, 0xdc,
-	0x7b, 0xec, 0xa4, 0x49, 0xd7, 0x0a, 0xf7, 0x21, 0x3e, 0x1f, 0x44, 0xdf, 0x2d, 0xe8, 0x3e, 0x2f,
-	0x2e, 0x43, 0x5f, 0x60, 0x6f, 0x10, 0x56, 0xd6, 0xe4, 0x22, 0xc7, 0x29, 0xd2, 0xd7, 0xd8, 0x89,
-	0xb2, 0x9a, 0x2a, 0x7b, 0x1c, 0x9c, 0x07, 0x97, 0xdd, 0xdb, 0x98, 0xef, 0x04, 0xf1, 0x51, 0xd3,
-	0x7a, 0xaa, 0x4b, 0x0f, 0xff, 0x32, 0x56, 0x6d, 0xa8, 0xec, 0x05, 0xfa, 0xd2, 0x68, 0x47, 0x42,
-	0xd3, 0x0a, 0xdf, 0xf2, 0xf8, 0xab, 0x3d, 0xf8, 0xe1, 0xb2, 0xd2, 0xb0, 0x7b, 0xf2, 0xb7, 0xc4,
-	0x26, 0x70, 0x64, 0x05, 0xa9, 0xf1, 0x14, 0x4b, 0x24, 0xd4, 0xc5, 0x8a, 0xde, 0xf6, 0x74, 0xbe,
-	0x87, 0x9e, 0x09, 0x52, 0x8f, 0xcb, 0x5a, 0xf3, 0x84, 0xd0, 0x6e, 0xca, 0xe9, 0x21, 0x1c, 0x2c,
-	0xb9, 0xd1, 0x3d, 0xb0, 0xcd, 0xa9, 0xd9, 0x0d, 0x84, 0x3e, 0x80, 0xba, 0x58, 0x73, 0xfd, 0x17,
-	0x0c, 0xb2, 0x6d, 0x56, 0x14, 0x43, 0xef, 0xcf, 0x78, 0xec, 0x14, 0x3a, 0x13, 0x25, 0xd1, 0xa1,
-	0xd1, 0xbe, 0xd9, 0xc9, 0x56, 0xf7, 0xe8, 0x02, 0xc2, 0x2d, 0xef, 0xcb, 0xfa, 0xd0, 0xfe, 0xac,
-	0x9c, 0x4f, 0xb7, 0xb3, 0xc5, 0x31, 0x9d, 0xc3, 0x19, 0x9a, 0xdd, 0x53, 0xa7, 0xfd, 0xb5, 0x1f,
-	0x3f, 0x5a, 0x58, 0xa3, 0xe0, 0x35, 0x2d, 0x90, 0x3e, 0x66, 0x39, 0x97, 0xa6, 0x4c, 0xea, 0x56,
-	0x8c, 0xda, 0x91, 0x9d, 0x95, 0x4a, 0x93, 0x20, 0x34, 0x3a, 0x69, 0x80, 0x71, 0xbd, 0x7a, 0x85,
-	0xd2, 0x71, 0xd1, 0x6c, 0x60, 0xfe, 0xdf, 0xcb, 0x77, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb2,
-	0x61, 0x29, 0x8f, 0xa5, 0x02, 0x00, 0x00,
+var File_opencensus_proto_trace_v1_trace_config_proto protoreflect.FileDescriptor
+
+var file_opencensus_proto_trace_v1_trace_config_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19,
+	0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x82, 0x04, 0x0a, 0x0b, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x60, 0x0a, 0x13, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x15, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x14, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x64, 0x0a,
+	0x15, 0x70, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13,
+	0x70, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x22, 0x46,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x13, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x71, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x71, 0x70, 0x73, 0x22, 0xca,
+	0x03, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x5a, 0x0a, 0x15, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x12, 0x61, 0x0a,
+	0x19, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64,
+	0x12, 0x58, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x12, 0x5f, 0x0a, 0x18, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x22, 0x98, 0x02, 0x0a, 0x13,
+	0x50, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x25, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x77,
+	0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x6e, 0x0a, 0x18, 0x70, 0x65, 0x72, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x16,
+	0x70, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_opencensus_proto_trace_v1_trace_config_proto_rawDescOnce sync.Once
+	file_opencensus_proto_trace_v1_trace_config_proto_rawDescData = file_opencensus_proto_trace_v1_trace_config_proto_rawDesc
+)
+
+func file_opencensus_proto_trace_v1_trace_config_proto_rawDescGZIP() []byte {
+	file_opencensus_proto_trace_v1_trace_config_proto_rawDescOnce.Do(func() {
+		file_opencensus_proto_trace_v1_trace_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_opencensus_proto_trace_v1_trace_config_proto_rawDescData)
+	})
+	return file_opencensus_proto_trace_v1_trace_config_proto_rawDescData
+}
+
+var file_opencensus_proto_trace_v1_trace_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_opencensus_proto_trace_v1_trace_config_proto_goTypes = []interface{}{
+	(*TraceConfig)(nil),               // 0: opencensus.proto.trace.v1.TraceConfig
+	(*ProbabilitySampler)(nil),        // 1: opencensus.proto.trace.v1.ProbabilitySampler
+	(*ConstantSampler)(nil),           // 2: opencensus.proto.trace.v1.ConstantSampler
+	(*RateLimitingSampler)(nil),       // 3: opencensus.proto.trace.v1.RateLimitingSampler
+	(*ParentBasedSampler)(nil),        // 4: opencensus.proto.trace.v1.ParentBasedSampler
+	(*PerOperationSampler)(nil),       // 5: opencensus.proto.trace.v1.PerOperationSampler
+	(*OperationSamplingStrategy)(nil), // 6: opencensus.proto.trace.v1.OperationSamplingStrategy
+}
+var file_opencensus_proto_trace_v1_trace_config_proto_depIdxs = []int32{
+	1,  // 0: opencensus.proto.trace.v1.TraceConfig.probability_sampler:type_name -> opencensus.proto.trace.v1.ProbabilitySampler
+	2,  // 1: opencensus.proto.trace.v1.TraceConfig.constant_sampler:type_name -> opencensus.proto.trace.v1.ConstantSampler
+	3,  // 2: opencensus.proto.trace.v1.TraceConfig.rate_limiting_sampler:type_name -> opencensus.proto.trace.v1.RateLimitingSampler
+	4,  // 3: opencensus.proto.trace.v1.TraceConfig.parent_based_sampler:type_name -> opencensus.proto.trace.v1.ParentBasedSampler
+	5,  // 4: opencensus.proto.trace.v1.TraceConfig.per_operation_sampler:type_name -> opencensus.proto.trace.v1.PerOperationSampler
+	0,  // 5: opencensus.proto.trace.v1.ParentBasedSampler.root:type_name -> opencensus.proto.trace.v1.TraceConfig
+	0,  // 6: opencensus.proto.trace.v1.ParentBasedSampler.remote_parent_sampled:type_name -> opencensus.proto.trace.v1.TraceConfig
+	0,  // 7: opencensus.proto.trace.v1.ParentBasedSampler.remote_parent_not_sampled:type_name -> opencensus.proto.trace.v1.TraceConfig
+	0,  // 8: opencensus.proto.trace.v1.ParentBasedSampler.local_parent_sampled:type_name -> opencensus.proto.trace.v1.TraceConfig
+	0,  // 9: opencensus.proto.trace.v1.ParentBasedSampler.local_parent_not_sampled:type_name -> opencensus.proto.trace.v1.TraceConfig
+	6,  // 10: opencensus.proto.trace.v1.PerOperationSampler.per_operation_strategies:type_name -> opencensus.proto.trace.v1.OperationSamplingStrategy
+	1,  // 11: opencensus.proto.trace.v1.OperationSamplingStrategy.probability_sampler:type_name -> opencensus.proto.trace.v1.ProbabilitySampler
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_opencensus_proto_trace_v1_trace_config_proto_init() }
+func file_opencensus_proto_trace_v1_trace_config_proto_init() {
+	if File_opencensus_proto_trace_v1_trace_config_proto != nil {
+		return
+	}
+	file_opencensus_proto_trace_v1_trace_config_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TraceConfig_ProbabilitySampler)(nil),
+		(*TraceConfig_ConstantSampler)(nil),
+		(*TraceConfig_RateLimitingSampler)(nil),
+		(*TraceConfig_ParentBasedSampler)(nil),
+		(*TraceConfig_PerOperationSampler)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_opencensus_proto_trace_v1_trace_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_opencensus_proto_trace_v1_trace_config_proto_goTypes,
+		DependencyIndexes: file_opencensus_proto_trace_v1_trace_config_proto_depIdxs,
+		MessageInfos:      file_opencensus_proto_trace_v1_trace_config_proto_msgTypes,
+	}.Build()
+	File_opencensus_proto_trace_v1_trace_config_proto = out.File
+	file_opencensus_proto_trace_v1_trace_config_proto_rawDesc = nil
+	file_opencensus_proto_trace_v1_trace_config_proto_goTypes = nil
+	file_opencensus_proto_trace_v1_trace_config_proto_depIdxs = nil
+}
+
+// TraceConfigV1 adapts a TraceConfig to the legacy github.com/golang/protobuf/proto.Message
+// interface for callers that have not yet migrated to google.golang.org/protobuf, via protoadapt.
+func TraceConfigV1(m *TraceConfig) protoadapt.MessageV1 {
+	return protoadapt.MessageV1Of(m)
 }
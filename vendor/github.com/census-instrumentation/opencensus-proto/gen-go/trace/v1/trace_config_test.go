@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// oldWireBytes holds TraceConfig messages encoded by the legacy protoc-gen-go v1 runtime, captured
+// before the migration to google.golang.org/protobuf. The wire format itself didn't change in the
+// migration (only the generated Go bindings did), so decoding these with the new APIv2-based
+// TraceConfig exercises that the regenerated code still understands bytes produced pre-migration.
+var oldWireBytes = map[string][]byte{
+	// probability_sampler (field 1) { samplingProbability: 0.5 (field 1, double) }
+	"probability": {0x0a, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f},
+	// constant_sampler (field 2) { decision: true (field 1, bool) }
+	"constant": {0x12, 0x02, 0x08, 0x01},
+	// rate_limiting_sampler (field 3) { qps: 100 (field 1, int64 varint) }
+	"rateLimiting": {0x1a, 0x02, 0x08, 0x64},
+}
+
+func TestTraceConfigRoundTripAgainstOldWireBytes(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(t *testing.T, cfg *TraceConfig)
+	}{
+		{
+			name: "probability",
+			check: func(t *testing.T, cfg *TraceConfig) {
+				s, ok := cfg.GetSampler().(*TraceConfig_ProbabilitySampler)
+				if !ok {
+					t.Fatalf("Sampler = %T, want *TraceConfig_ProbabilitySampler", cfg.GetSampler())
+				}
+				if got, want := s.ProbabilitySampler.GetSamplingProbability(), 0.5; got != want {
+					t.Errorf("SamplingProbability = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "constant",
+			check: func(t *testing.T, cfg *TraceConfig) {
+				s, ok := cfg.GetSampler().(*TraceConfig_ConstantSampler)
+				if !ok {
+					t.Fatalf("Sampler = %T, want *TraceConfig_ConstantSampler", cfg.GetSampler())
+				}
+				if !s.ConstantSampler.GetDecision() {
+					t.Errorf("Decision = false, want true")
+				}
+			},
+		},
+		{
+			name: "rateLimiting",
+			check: func(t *testing.T, cfg *TraceConfig) {
+				s, ok := cfg.GetSampler().(*TraceConfig_RateLimitingSampler)
+				if !ok {
+					t.Fatalf("Sampler = %T, want *TraceConfig_RateLimitingSampler", cfg.GetSampler())
+				}
+				if got, want := s.RateLimitingSampler.GetQps(), int64(100); got != want {
+					t.Errorf("Qps = %v, want %v", got, want)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg TraceConfig
+			if err := proto.Unmarshal(oldWireBytes[tt.name], &cfg); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			tt.check(t, &cfg)
+
+			// Round-trip: re-marshaling and re-decoding must agree with the original decode.
+			reencoded, err := proto.Marshal(&cfg)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			var roundTripped TraceConfig
+			if err := proto.Unmarshal(reencoded, &roundTripped); err != nil {
+				t.Fatalf("Unmarshal(re-encoded): %v", err)
+			}
+			tt.check(t, &roundTripped)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+)
+
+// Watcher dials a TraceConfigService, reports the library's current config on connect, and
+// invokes OnUpdate whenever the server pushes a new one. It reconnects with exponential backoff
+// if the stream breaks.
+type Watcher struct {
+	// CurrentConfig returns the TraceConfig the library currently has applied; it is sent on every
+	// (re)connect so the server can decide whether to push an update.
+	CurrentConfig func() *TraceConfig
+	// OnUpdate is invoked with the new TraceConfig each time the server pushes one. Implementations
+	// typically swap the process-wide sampler here.
+	OnUpdate func(*TraceConfig)
+
+	// MinBackoff and MaxBackoff bound the reconnect delay. Zero values fall back to 1s and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Watch connects to client and blocks, applying updates via w.OnUpdate, until ctx is canceled.
+func (w *Watcher) Watch(ctx context.Context, client TraceConfigServiceClient) {
+	backoff := w.MinBackoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+	maxBackoff := w.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		err := w.watchOnce(ctx, client)
+		if err == nil {
+			// The stream ended cleanly (server closed it, e.g. on shutdown): the session was
+			// healthy, so don't carry an escalated backoff into the next reconnect attempt.
+			backoff = w.MinBackoff
+			if backoff <= 0 {
+				backoff = time.Second
+			}
+		} else {
+			log.Printf("trace config watcher: stream ended: %v, reconnecting in %s", err, backoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if err != nil {
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+	}
+}
+
+func (w *Watcher) watchOnce(ctx context.Context, client TraceConfigServiceClient) error {
+	stream, err := client.Config(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&CurrentLibraryConfig{Config: w.CurrentConfig()}); err != nil {
+		return err
+	}
+
+	for {
+		updated, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		w.OnUpdate(updated.GetConfig())
+	}
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWatcherObservesPushedConfigsInOrder(t *testing.T) {
+	server := NewFakeTraceConfigServiceServer()
+
+	received := make(chan *TraceConfig, 3)
+	w := &Watcher{
+		CurrentConfig: func() *TraceConfig { return &TraceConfig{} },
+		OnUpdate:      func(cfg *TraceConfig) { received <- cfg },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Watch(ctx, server.Dial())
+
+	pushed := []*TraceConfig{
+		{Sampler: &TraceConfig_ProbabilitySampler{ProbabilitySampler: &ProbabilitySampler{SamplingProbability: 0.5}}},
+		{Sampler: &TraceConfig_ConstantSampler{ConstantSampler: &ConstantSampler{Decision: true}}},
+		{Sampler: &TraceConfig_RateLimitingSampler{RateLimitingSampler: &RateLimitingSampler{Qps: 100}}},
+	}
+
+	for i, cfg := range pushed {
+		// Wait until the watcher has connected before the first Push, otherwise Push silently
+		// drops the update (no connected clients yet).
+		if i == 0 {
+			waitForConnection(t, server)
+		}
+		server.Push(cfg)
+
+		select {
+		case got := <-received:
+			assertSameSampler(t, i, got, cfg)
+		case <-time.After(time.Second):
+			t.Fatalf("update %d: OnUpdate was not invoked within 1s", i)
+		}
+	}
+}
+
+// waitForConnection blocks until the fake server has at least one live watcher connection.
+func waitForConnection(t *testing.T, server *FakeTraceConfigServiceServer) {
+	t.Helper()
+	deadline := time.After(time.Second)
+	for {
+		server.mu.Lock()
+		n := len(server.conns)
+		server.mu.Unlock()
+		if n > 0 {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("watcher never connected to the fake server")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func assertSameSampler(t *testing.T, i int, got, want *TraceConfig) {
+	t.Helper()
+	switch w := want.GetSampler().(type) {
+	case *TraceConfig_ProbabilitySampler:
+		g, ok := got.GetSampler().(*TraceConfig_ProbabilitySampler)
+		if !ok || g.ProbabilitySampler.GetSamplingProbability() != w.ProbabilitySampler.GetSamplingProbability() {
+			t.Errorf("update %d: got %+v, want probability sampler matching %+v", i, got, want)
+		}
+	case *TraceConfig_ConstantSampler:
+		g, ok := got.GetSampler().(*TraceConfig_ConstantSampler)
+		if !ok || g.ConstantSampler.GetDecision() != w.ConstantSampler.GetDecision() {
+			t.Errorf("update %d: got %+v, want constant sampler matching %+v", i, got, want)
+		}
+	case *TraceConfig_RateLimitingSampler:
+		g, ok := got.GetSampler().(*TraceConfig_RateLimitingSampler)
+		if !ok || g.RateLimitingSampler.GetQps() != w.RateLimitingSampler.GetQps() {
+			t.Errorf("update %d: got %+v, want rate-limiting sampler matching %+v", i, got, want)
+		}
+	default:
+		t.Fatalf("update %d: unexpected want sampler type %T", i, want)
+	}
+}